@@ -0,0 +1,178 @@
+// Command server runs the Hello gRPC service and its grpc-gateway HTTP
+// transcoding mux side-by-side, so SayHello can be called as either gRPC
+// or JSON/REST.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "WeekLast/project-demo/api/hello/v1"
+	"WeekLast/project-demo/pkg/interceptors"
+)
+
+var (
+	grpcAddr = flag.String("grpc-addr", ":8080", "address for the gRPC server")
+	httpAddr = flag.String("http-addr", ":8081", "address for the grpc-gateway HTTP mux")
+)
+
+type helloServer struct {
+	v1.UnimplementedHelloServer
+}
+
+func (helloServer) SayHello(ctx context.Context, req *v1.HelloReq) (*emptypb.Empty, error) {
+	log.Printf("SayHello: %s", req.GetName())
+	return &emptypb.Empty{}, nil
+}
+
+// SayHelloServerStream sends a greeting every second until the client
+// cancels or the stream context is otherwise done.
+func (helloServer) SayHelloServerStream(req *v1.HelloReq, stream v1.Hello_SayHelloServerStreamServer) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+			if err := stream.Send(&v1.HelloResp{Content: "hello, " + req.GetName()}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SayHelloClientStream aggregates every name received into a single
+// response once the client closes its send side.
+func (helloServer) SayHelloClientStream(stream v1.Hello_SayHelloClientStreamServer) error {
+	var names []string
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&v1.HelloResp{Content: "hello, " + strings.Join(names, ", ")})
+		}
+		if err != nil {
+			return err
+		}
+		names = append(names, req.GetName())
+	}
+}
+
+// SayHelloBidi greets each request as it arrives and exits cleanly when the
+// client cancels the stream context.
+func (helloServer) SayHelloBidi(stream v1.Hello_SayHelloBidiServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		default:
+		}
+		if err := stream.Send(&v1.HelloResp{Content: "hello, " + req.GetName()}); err != nil {
+			return err
+		}
+	}
+}
+
+// runBidiDemo exercises SayHelloBidi end to end and shows the expected
+// shutdown path: cancelling streamCtx makes both Send and Recv return, and
+// the server's blocked stream.Recv() returns the cancellation error, which
+// ends its own loop in turn.
+func runBidiDemo(ctx context.Context, client v1.HelloClient) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.SayHelloBidi(streamCtx)
+	if err != nil {
+		log.Printf("bidi demo: open stream: %v", err)
+		return
+	}
+
+	go func() {
+		for _, name := range []string{"alice", "bob"} {
+			if err := stream.Send(&v1.HelloReq{Name: name}); err != nil {
+				return
+			}
+		}
+		cancel()
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			log.Printf("bidi demo: stream closed: %v", err)
+			return
+		}
+		log.Printf("bidi demo: %s", resp.GetContent())
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("build logger: %v", err)
+	}
+	defer logger.Sync()
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *grpcAddr, err)
+	}
+
+	grpcServer := interceptors.NewServer(
+		interceptors.WithLogger(logger),
+		interceptors.WithGRPCServerOptions(grpc.MaxConcurrentStreams(64)),
+	)
+	v1.RegisterHelloServer(grpcServer, helloServer{})
+	go func() {
+		log.Printf("gRPC server listening on %s", *grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("grpc server: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := interceptors.Dial(*grpcAddr,
+		interceptors.WithRetry(interceptors.WithMax(3), interceptors.WithPerRetryTimeout(2*time.Second)),
+		interceptors.WithGRPCDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	if err != nil {
+		log.Fatalf("dial %s: %v", *grpcAddr, err)
+	}
+	defer conn.Close()
+
+	mux := runtime.NewServeMux()
+	if err := v1.RegisterHelloHandler(ctx, mux, conn); err != nil {
+		log.Fatalf("register gateway handler: %v", err)
+	}
+
+	go runBidiDemo(ctx, v1.NewHelloClient(conn))
+
+	log.Printf("grpc-gateway HTTP mux listening on %s", *httpAddr)
+	if err := http.ListenAndServe(*httpAddr, mux); err != nil {
+		log.Fatalf("http server: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package interceptors
+
+import (
+	"google.golang.org/grpc"
+)
+
+// clientOptions holds the configuration assembled by ClientOption values
+// passed to Dial.
+type clientOptions struct {
+	retryOpts []RetryCallOption
+	extra     []grpc.UnaryClientInterceptor
+	grpcOpts  []grpc.DialOption
+}
+
+// ClientOption configures Dial.
+type ClientOption func(*clientOptions)
+
+// WithRetry configures the retry interceptor installed by Dial. Without
+// this option, Dial installs a retry interceptor with its defaults (no
+// retries, codes.Unavailable only).
+func WithRetry(opts ...RetryCallOption) ClientOption {
+	return func(o *clientOptions) { o.retryOpts = opts }
+}
+
+// WithUnaryClientInterceptors appends additional unary client interceptors
+// after the retry interceptor.
+func WithUnaryClientInterceptors(interceptors ...grpc.UnaryClientInterceptor) ClientOption {
+	return func(o *clientOptions) { o.extra = append(o.extra, interceptors...) }
+}
+
+// WithGRPCDialOptions passes through arbitrary grpc.DialOption values, e.g.
+// transport credentials.
+func WithGRPCDialOptions(opts ...grpc.DialOption) ClientOption {
+	return func(o *clientOptions) { o.grpcOpts = append(o.grpcOpts, opts...) }
+}
+
+// Dial opens a *grpc.ClientConn to target with the standard client-side
+// interceptor chain (retry, then any caller-supplied interceptors) already
+// installed.
+func Dial(target string, opts ...ClientOption) (*grpc.ClientConn, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	unary := append([]grpc.UnaryClientInterceptor{
+		RetryUnaryClientInterceptor(o.retryOpts...),
+	}, o.extra...)
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unary...),
+	}, o.grpcOpts...)
+
+	return grpc.NewClient(target, dialOpts...)
+}
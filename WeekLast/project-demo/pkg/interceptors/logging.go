@@ -0,0 +1,55 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingUnaryServerInterceptor logs method, latency, peer address and
+// status code for every unary call, at info level for codes.OK and warn
+// otherwise.
+func LoggingUnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(logger, ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// LoggingStreamServerInterceptor is the streaming counterpart of
+// LoggingUnaryServerInterceptor; it logs once the stream handler returns.
+func LoggingStreamServerInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(logger, ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logCall(logger *zap.Logger, ctx context.Context, method string, start time.Time, err error) {
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.Duration("latency", time.Since(start)),
+		zap.String("code", status.Code(err).String()),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+	if id := RequestIDFromContext(ctx); id != "" {
+		fields = append(fields, zap.String("request_id", id))
+	}
+
+	if status.Code(err) == codes.OK {
+		logger.Info("grpc call", fields...)
+		return
+	}
+	logger.Warn("grpc call", append(fields, zap.Error(err))...)
+}
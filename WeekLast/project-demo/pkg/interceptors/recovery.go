@@ -0,0 +1,38 @@
+package interceptors
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryServerInterceptor recovers panics raised by the handler and
+// converts them to codes.Internal instead of crashing the server.
+func RecoveryUnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("recovered from panic", zap.String("method", info.FullMethod), zap.Any("panic", r))
+				err = status.Errorf(codes.Internal, "internal error: %v", r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamServerInterceptor is the streaming counterpart of
+// RecoveryUnaryServerInterceptor.
+func RecoveryStreamServerInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("recovered from panic", zap.String("method", info.FullMethod), zap.Any("panic", r))
+				err = status.Errorf(codes.Internal, "internal error: %v", r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
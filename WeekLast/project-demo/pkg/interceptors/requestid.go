@@ -0,0 +1,74 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDKey is the metadata key used to carry the per-call correlation
+// id injected by RequestIDUnaryServerInterceptor; inside the context the id
+// is stored under requestIDCtxKey.
+const requestIDKey = "x-request-id"
+
+type requestIDCtxKey struct{}
+
+// RequestIDFromContext returns the correlation id stashed in ctx by the
+// request-id interceptor, or "" if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return id
+}
+
+// RequestIDUnaryServerInterceptor injects a correlation id into both the
+// request context and the outgoing grpc metadata, generating one when the
+// caller did not already supply an x-request-id.
+func RequestIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestID(ctx)
+		return handler(ctx, req)
+	}
+}
+
+// RequestIDStreamServerInterceptor is the streaming counterpart of
+// RequestIDUnaryServerInterceptor.
+func RequestIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := &serverStreamWithContext{ServerStream: ss, ctx: withRequestID(ss.Context())}
+		return handler(srv, wrapped)
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	id := requestIDFromIncoming(ctx)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, requestIDKey, id)
+	return context.WithValue(ctx, requestIDCtxKey{}, id)
+}
+
+func requestIDFromIncoming(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(requestIDKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// serverStreamWithContext overrides grpc.ServerStream.Context so interceptors
+// further down the chain observe the enriched context.
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStreamWithContext) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,92 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retryOptions configures RetryUnaryClientInterceptor. Its defaults are set
+// by newRetryOptions before any RetryCallOption is applied.
+type retryOptions struct {
+	max             uint
+	perRetryTimeout time.Duration
+	codes           []codes.Code
+	backoffBase     time.Duration
+}
+
+func newRetryOptions() *retryOptions {
+	return &retryOptions{
+		max:         0,
+		backoffBase: 100 * time.Millisecond,
+		codes:       []codes.Code{codes.Unavailable},
+	}
+}
+
+// RetryCallOption configures the client-side retry interceptor.
+type RetryCallOption func(*retryOptions)
+
+// WithMax sets the maximum number of retry attempts after the initial call.
+func WithMax(max uint) RetryCallOption {
+	return func(o *retryOptions) { o.max = max }
+}
+
+// WithPerRetryTimeout bounds each individual attempt, including the first.
+func WithPerRetryTimeout(timeout time.Duration) RetryCallOption {
+	return func(o *retryOptions) { o.perRetryTimeout = timeout }
+}
+
+// WithCodes overrides the set of grpc status codes that are considered
+// retryable. The default is codes.Unavailable.
+func WithCodes(retryCodes ...codes.Code) RetryCallOption {
+	return func(o *retryOptions) { o.codes = retryCodes }
+}
+
+// RetryUnaryClientInterceptor retries failed unary calls with exponential
+// backoff, up to the configured maximum, as long as the returned status
+// code is in the retryable set.
+func RetryUnaryClientInterceptor(opts ...RetryCallOption) grpc.UnaryClientInterceptor {
+	o := newRetryOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		var lastErr error
+		for attempt := uint(0); attempt <= o.max; attempt++ {
+			callCtx := ctx
+			var cancel context.CancelFunc
+			if o.perRetryTimeout > 0 {
+				callCtx, cancel = context.WithTimeout(ctx, o.perRetryTimeout)
+			}
+
+			lastErr = invoker(callCtx, method, req, reply, cc, callOpts...)
+			if cancel != nil {
+				cancel()
+			}
+			if lastErr == nil || !isRetryable(lastErr, o.codes) || attempt == o.max {
+				return lastErr
+			}
+
+			select {
+			case <-time.After(o.backoffBase * (1 << attempt)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return lastErr
+	}
+}
+
+func isRetryable(err error, retryCodes []codes.Code) bool {
+	code := status.Code(err)
+	for _, c := range retryCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
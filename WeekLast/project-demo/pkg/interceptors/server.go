@@ -0,0 +1,75 @@
+package interceptors
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// serverOptions holds the configuration assembled by ServerOption values
+// passed to NewServer.
+type serverOptions struct {
+	logger      *zap.Logger
+	extraUnary  []grpc.UnaryServerInterceptor
+	extraStream []grpc.StreamServerInterceptor
+	grpcOpts    []grpc.ServerOption
+}
+
+// ServerOption configures NewServer.
+type ServerOption func(*serverOptions)
+
+// WithLogger overrides the zap logger used by the logging and recovery
+// interceptors. Defaults to zap.NewNop() when not set.
+func WithLogger(logger *zap.Logger) ServerOption {
+	return func(o *serverOptions) { o.logger = logger }
+}
+
+// WithUnaryInterceptors appends additional unary interceptors after the
+// standard chain (validation, request id, logging, recovery).
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) ServerOption {
+	return func(o *serverOptions) { o.extraUnary = append(o.extraUnary, interceptors...) }
+}
+
+// WithStreamInterceptors appends additional stream interceptors after the
+// standard chain.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) ServerOption {
+	return func(o *serverOptions) { o.extraStream = append(o.extraStream, interceptors...) }
+}
+
+// WithGRPCServerOptions passes through arbitrary grpc.ServerOption values,
+// e.g. grpc.MaxConcurrentStreams.
+func WithGRPCServerOptions(opts ...grpc.ServerOption) ServerOption {
+	return func(o *serverOptions) { o.grpcOpts = append(o.grpcOpts, opts...) }
+}
+
+// NewServer builds a *grpc.Server with the standard interceptor chain
+// (recovery, request id, logging, validation, in that call order) already
+// installed, plus any caller-supplied options. Callers should use this
+// instead of grpc.NewServer directly so every service gets the same
+// cross-cutting behavior.
+func NewServer(opts ...ServerOption) *grpc.Server {
+	o := &serverOptions{logger: zap.NewNop()}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	unary := append([]grpc.UnaryServerInterceptor{
+		RecoveryUnaryServerInterceptor(o.logger),
+		RequestIDUnaryServerInterceptor(),
+		LoggingUnaryServerInterceptor(o.logger),
+		ValidatorUnaryServerInterceptor(),
+	}, o.extraUnary...)
+
+	stream := append([]grpc.StreamServerInterceptor{
+		RecoveryStreamServerInterceptor(o.logger),
+		RequestIDStreamServerInterceptor(),
+		LoggingStreamServerInterceptor(o.logger),
+		ValidatorStreamServerInterceptor(),
+	}, o.extraStream...)
+
+	grpcOpts := append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}, o.grpcOpts...)
+
+	return grpc.NewServer(grpcOpts...)
+}
@@ -0,0 +1,56 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validate is shared across calls; the underlying struct is safe for
+// concurrent use once tag caches are warm.
+var validate = validator.New()
+
+// ValidatorUnaryServerInterceptor runs go-playground/validator against the
+// incoming request message and rejects it with codes.InvalidArgument when a
+// `validate` tag fails.
+func ValidatorUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateRequest(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidatorStreamServerInterceptor validates every message received on the
+// stream before it reaches the handler.
+func ValidatorStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss})
+	}
+}
+
+func validateRequest(req interface{}) error {
+	if err := validate.Struct(req); err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			// req is nil or not a struct; there is nothing to validate.
+			return nil
+		}
+		return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return nil
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}